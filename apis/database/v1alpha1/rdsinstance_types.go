@@ -0,0 +1,215 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// RDSInstance states. See the Alibaba Cloud RDS API docs for details.
+const (
+	RDSInstanceStateCreating = "Creating"
+	RDSInstanceStateRunning  = "Running"
+	RDSInstanceStateDeleting = "Deleting"
+)
+
+// Instance network types, mirroring the Classic vs VPC split that the
+// Alibaba Cloud RDS API exposes.
+const (
+	// RDSInstanceNetworkTypeClassic places the instance on the classic
+	// network, reachable only via its public connection string.
+	RDSInstanceNetworkTypeClassic = "Classic"
+	// RDSInstanceNetworkTypeVPC places the instance inside a VPC, reachable
+	// via a private connection string in addition to an optional public one.
+	RDSInstanceNetworkTypeVPC = "VPC"
+)
+
+// Endpoint describes a connection endpoint for an RDSInstance, as returned by
+// the Alibaba Cloud RDS API.
+type Endpoint struct {
+	// Address is the connection address, e.g. a hostname or IP.
+	Address string `json:"address,omitempty"`
+
+	// Port is the connection port.
+	Port string `json:"port,omitempty"`
+}
+
+// RDSInstanceParameters define the desired state of an Alibaba Cloud
+// Relational Database Service instance.
+type RDSInstanceParameters struct {
+	// Engine is the name of the database engine to be used for this
+	// instance, e.g. MySQL, PostgreSQL, SQLServer, PPAS, MariaDB.
+	Engine string `json:"engine"`
+
+	// EngineVersion indicates the database engine version.
+	EngineVersion string `json:"engineVersion"`
+
+	// DBInstanceClass specifies the class of the RDS instance, e.g.
+	// rds.pg.s1.small.
+	DBInstanceClass string `json:"dbInstanceClass"`
+
+	// DBInstanceStorageInGB specifies the allocated storage size, in GB.
+	DBInstanceStorageInGB int `json:"dbInstanceStorageInGB"`
+
+	// MasterUsername is the name for the master database user.
+	MasterUsername string `json:"masterUsername"`
+
+	// SecurityIPList is a comma separated list of IPs/CIDRs permitted to
+	// access the instance, e.g. 0.0.0.0/0.
+	// +optional
+	SecurityIPList string `json:"securityIPList,omitempty"`
+
+	// InstanceNetworkType specifies whether the instance is placed on the
+	// classic network or inside a VPC. Defaults to Classic when omitted.
+	// +optional
+	// +kubebuilder:validation:Enum=Classic;VPC
+	InstanceNetworkType string `json:"instanceNetworkType,omitempty"`
+
+	// VPCID is the ID of the VPC the instance should be attached to. Only
+	// used when InstanceNetworkType is VPC.
+	// +optional
+	VPCID string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its ID.
+	// +optional
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its ID.
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// VSwitchID is the ID of the VSwitch the instance should be attached to.
+	// Only used when InstanceNetworkType is VPC.
+	// +optional
+	VSwitchID string `json:"vSwitchId,omitempty"`
+
+	// VSwitchIDRef references a VSwitch to retrieve its ID.
+	// +optional
+	VSwitchIDRef *xpv1.Reference `json:"vSwitchIdRef,omitempty"`
+
+	// VSwitchIDSelector selects a reference to a VSwitch to retrieve its ID.
+	// +optional
+	VSwitchIDSelector *xpv1.Selector `json:"vSwitchIdSelector,omitempty"`
+
+	// ZoneID is the ID of the availability zone the instance is created in.
+	// +optional
+	ZoneID string `json:"zoneId,omitempty"`
+
+	// PrivateIPAddress is the private IP address to assign the instance
+	// within its VSwitch. Only used when InstanceNetworkType is VPC.
+	// +optional
+	PrivateIPAddress string `json:"privateIpAddress,omitempty"`
+
+	// Parameters holds engine parameter group values to apply to the
+	// instance, e.g. max_connections.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// MasterPasswordSecretRef references a key within a Secret containing
+	// the desired master account password. When the referenced value
+	// changes the controller rotates the account password to match.
+	// +optional
+	MasterPasswordSecretRef *xpv1.SecretKeySelector `json:"masterPasswordSecretRef,omitempty"`
+}
+
+// RDSInstanceObservation is used to reflect in the cluster what the cloud
+// provider is reporting about the state of the RDSInstance.
+type RDSInstanceObservation struct {
+	// DBInstanceID is the Alibaba Cloud identifier for this instance.
+	DBInstanceID string `json:"dbInstanceId,omitempty"`
+
+	// DBInstanceStatus reflects the current status of the instance as
+	// reported by Alibaba Cloud, e.g. Creating, Running, Deleting.
+	DBInstanceStatus string `json:"dbInstanceStatus,omitempty"`
+
+	// AccountReady indicates whether the master account has been created
+	// for this instance.
+	AccountReady bool `json:"accountReady,omitempty"`
+
+	// DBInstanceClass is the class Alibaba Cloud currently reports for this
+	// instance. Compared against spec.forProvider.dbInstanceClass to detect
+	// drift that should be resolved with a resize.
+	DBInstanceClass string `json:"dbInstanceClass,omitempty"`
+
+	// DBInstanceStorageInGB is the storage size, in GB, Alibaba Cloud
+	// currently reports for this instance.
+	DBInstanceStorageInGB int `json:"dbInstanceStorageInGB,omitempty"`
+
+	// SecurityIPList is the allowlist Alibaba Cloud currently reports for
+	// this instance.
+	SecurityIPList string `json:"securityIPList,omitempty"`
+
+	// Parameters holds the engine parameter group values Alibaba Cloud
+	// currently reports for this instance.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// MasterPasswordVersion is a hash of the master account password that
+	// was last applied via ResetAccountPassword, used to detect when the
+	// referenced secret's value has changed.
+	// +optional
+	MasterPasswordVersion string `json:"masterPasswordVersion,omitempty"`
+
+	// Endpoint is the public connection endpoint for this instance.
+	// +optional
+	Endpoint *Endpoint `json:"endpoint,omitempty"`
+
+	// PrivateEndpoint is the private (VPC) connection endpoint for this
+	// instance. Only populated when InstanceNetworkType is VPC.
+	// +optional
+	PrivateEndpoint *Endpoint `json:"privateEndpoint,omitempty"`
+}
+
+// A RDSInstanceSpec defines the desired state of an RDSInstance.
+type RDSInstanceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RDSInstanceParameters `json:"forProvider"`
+}
+
+// A RDSInstanceStatus represents the observed state of an RDSInstance.
+type RDSInstanceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RDSInstanceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.dbInstanceStatus"
+// +kubebuilder:resource:scope=Cluster
+
+// An RDSInstance is a managed resource that represents an Alibaba Cloud
+// Relational Database Service instance.
+type RDSInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RDSInstanceSpec   `json:"spec"`
+	Status RDSInstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RDSInstanceList contains a list of RDSInstance.
+type RDSInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RDSInstance `json:"items"`
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// RedisInstance states. See the Alibaba Cloud ApsaraDB for Redis API docs
+// for details.
+const (
+	RedisInstanceStateCreating = "Creating"
+	RedisInstanceStateRunning  = "Normal"
+	RedisInstanceStateDeleting = "Deleting"
+)
+
+// RedisInstanceParameters define the desired state of an Alibaba Cloud
+// ApsaraDB for Redis (R-KVStore) instance.
+type RedisInstanceParameters struct {
+	// InstanceClass specifies the class of the Redis instance, e.g.
+	// redis.master.small.default.
+	InstanceClass string `json:"instanceClass"`
+
+	// EngineVersion is the Redis engine version, e.g. 4.0, 5.0, 6.0.
+	// +kubebuilder:validation:Enum=4.0;5.0;6.0
+	EngineVersion string `json:"engineVersion"`
+
+	// Capacity is the requested memory capacity, in MB.
+	Capacity int `json:"capacity"`
+
+	// InstanceNetworkType specifies whether the instance is placed on the
+	// classic network or inside a VPC. Defaults to Classic when omitted.
+	// +optional
+	// +kubebuilder:validation:Enum=Classic;VPC
+	InstanceNetworkType string `json:"instanceNetworkType,omitempty"`
+
+	// VPCID is the ID of the VPC the instance should be attached to. Only
+	// used when InstanceNetworkType is VPC.
+	// +optional
+	VPCID string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its ID.
+	// +optional
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its ID.
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// VSwitchID is the ID of the VSwitch the instance should be attached to.
+	// Only used when InstanceNetworkType is VPC.
+	// +optional
+	VSwitchID string `json:"vSwitchId,omitempty"`
+
+	// VSwitchIDRef references a VSwitch to retrieve its ID.
+	// +optional
+	VSwitchIDRef *xpv1.Reference `json:"vSwitchIdRef,omitempty"`
+
+	// VSwitchIDSelector selects a reference to a VSwitch to retrieve its ID.
+	// +optional
+	VSwitchIDSelector *xpv1.Selector `json:"vSwitchIdSelector,omitempty"`
+}
+
+// RedisInstanceObservation is used to reflect in the cluster what the cloud
+// provider is reporting about the state of the RedisInstance.
+type RedisInstanceObservation struct {
+	// InstanceID is the Alibaba Cloud identifier for this instance.
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// InstanceStatus reflects the current status of the instance as
+	// reported by Alibaba Cloud, e.g. Creating, Normal, Deleting.
+	InstanceStatus string `json:"instanceStatus,omitempty"`
+
+	// InstanceClass is the class Alibaba Cloud currently reports for this
+	// instance. Compared against spec.forProvider.instanceClass to detect
+	// drift that should be resolved with a resize.
+	InstanceClass string `json:"instanceClass,omitempty"`
+
+	// Endpoint is the connection endpoint for this instance.
+	// +optional
+	Endpoint *Endpoint `json:"endpoint,omitempty"`
+}
+
+// A RedisInstanceSpec defines the desired state of a RedisInstance.
+type RedisInstanceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RedisInstanceParameters `json:"forProvider"`
+}
+
+// A RedisInstanceStatus represents the observed state of a RedisInstance.
+type RedisInstanceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RedisInstanceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.instanceStatus"
+// +kubebuilder:resource:scope=Cluster
+
+// A RedisInstance is a managed resource that represents an Alibaba Cloud
+// ApsaraDB for Redis instance.
+type RedisInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RedisInstanceSpec   `json:"spec"`
+	Status RedisInstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RedisInstanceList contains a list of RedisInstance.
+type RedisInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RedisInstance `json:"items"`
+}
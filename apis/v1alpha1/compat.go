@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// The following aliases preserve the names these types had under
+// crossplane-runtime's apis/core/v1alpha1 for one release while consumers
+// migrate to apis/common/v1.
+//
+// Deprecated: Use the equivalent xpv1 type instead. These aliases will be
+// removed in a future release.
+type (
+	ResourceSpec        = xpv1.ResourceSpec
+	ResourceStatus      = xpv1.ResourceStatus
+	ProviderCredentials = xpv1.ProviderCredentials
+	SecretKeySelector   = xpv1.SecretKeySelector
+	SecretReference     = xpv1.SecretReference
+	Reference           = xpv1.Reference
+	Selector            = xpv1.Selector
+)
+
+// Deprecated: Use xpv1.CredentialsSourceSecret instead.
+const CredentialsSourceSecret = xpv1.CredentialsSourceSecret
+
+// Deprecated: Use the equivalent xpv1.ResourceCredentialsSecret*Key constant
+// instead.
+const (
+	ResourceCredentialsSecretUserKey            = xpv1.ResourceCredentialsSecretUserKey
+	ResourceCredentialsSecretPasswordKey        = xpv1.ResourceCredentialsSecretPasswordKey
+	ResourceCredentialsSecretEndpointKey        = xpv1.ResourceCredentialsSecretEndpointKey
+	ResourceCredentialsSecretPortKey            = xpv1.ResourceCredentialsSecretPortKey
+	ResourceCredentialsSecretAccessKeyIDKey     = xpv1.ResourceCredentialsSecretAccessKeyIDKey
+	ResourceCredentialsSecretAccessKeySecretKey = xpv1.ResourceCredentialsSecretAccessKeySecretKey
+)
+
+// Deprecated: Use xpv1.Available instead.
+var Available = xpv1.Available
+
+// Deprecated: Use xpv1.Creating instead.
+var Creating = xpv1.Creating
+
+// Deprecated: Use xpv1.Deleting instead.
+var Deleting = xpv1.Deleting
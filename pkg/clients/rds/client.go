@@ -0,0 +1,298 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rds provides a client for the Alibaba Cloud RDS API.
+package rds
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	sdkrds "github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-alibaba/apis/database/v1alpha1"
+)
+
+const (
+	errCreateRDSSDKClient = "cannot create RDS SDK client"
+	errMarshalParameters  = "cannot marshal engine parameters"
+)
+
+// DBInstance represents the observed state of an Alibaba Cloud RDS instance.
+type DBInstance struct {
+	ID                    string
+	Status                string
+	Engine                string
+	EngineVersion         string
+	DBInstanceClass       string
+	DBInstanceStorageInGB int
+	SecurityIPList        string
+	Parameters            map[string]string
+	Endpoint              *v1alpha1.Endpoint
+	PrivateEndpoint       *v1alpha1.Endpoint
+}
+
+// CreateDBInstanceRequest captures the parameters needed to create a new RDS
+// instance.
+type CreateDBInstanceRequest struct {
+	Name                  string
+	Engine                string
+	EngineVersion         string
+	DBInstanceClass       string
+	DBInstanceStorageInGB int
+	SecurityIPList        string
+
+	// InstanceNetworkType is Classic or VPC. VPCID, VSwitchID, ZoneID and
+	// PrivateIPAddress are only meaningful when it is VPC.
+	InstanceNetworkType string
+	VPCID               string
+	VSwitchID           string
+	ZoneID              string
+	PrivateIPAddress    string
+}
+
+// Client implements the Alibaba Cloud RDS API operations this provider
+// depends on.
+type Client interface {
+	DescribeDBInstance(id string) (*DBInstance, error)
+	CreateDBInstance(req *CreateDBInstanceRequest) (*DBInstance, error)
+	CreateAccount(id, user, password string) error
+	DeleteDBInstance(id string) error
+
+	// ModifyDBInstanceSpec resizes an instance to the given class and
+	// storage size.
+	ModifyDBInstanceSpec(id, class string, storageInGB int) error
+	// ModifySecurityIPs replaces an instance's IP allowlist.
+	ModifySecurityIPs(id, ipList string) error
+	// ModifyParameter applies engine parameter group values to an instance.
+	ModifyParameter(id string, params map[string]string) error
+	// ResetAccountPassword rotates the password of an existing account.
+	ResetAccountPassword(id, user, password string) error
+	// DescribeAccount reports whether the named account exists on the
+	// instance and is available for use.
+	DescribeAccount(id, user string) (bool, error)
+}
+
+type client struct {
+	rds *sdkrds.Client
+}
+
+// NewClient creates a new Client for the given Alibaba Cloud credentials and
+// region. ctx is accepted to match the signature connectors use to inject a
+// client constructor, but SDK client construction does not itself make any
+// calls that need to be cancelled.
+func NewClient(ctx context.Context, accessKeyID, accessKeySecret, region string) (Client, error) {
+	c, err := sdkrds.NewClientWithAccessKey(region, accessKeyID, accessKeySecret)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateRDSSDKClient)
+	}
+	return &client{rds: c}, nil
+}
+
+// DescribeDBInstance looks up an RDS instance by ID.
+func (c *client) DescribeDBInstance(id string) (*DBInstance, error) {
+	req := sdkrds.CreateDescribeDBInstanceAttributeRequest()
+	req.DBInstanceId = id
+
+	resp, err := c.rds.DescribeDBInstanceAttribute(req)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Items.DBInstanceAttribute) == 0 {
+		return nil, errors.Errorf("no instance found for id %s", id)
+	}
+	attr := resp.Items.DBInstanceAttribute[0]
+	storageInGB, _ := strconv.Atoi(attr.DBInstanceStorage)
+
+	endpoint, privateEndpoint, err := c.describeEndpoints(id)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := c.describeParameters(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DBInstance{
+		ID:                    attr.DBInstanceId,
+		Status:                attr.DBInstanceStatus,
+		Engine:                attr.Engine,
+		EngineVersion:         attr.EngineVersion,
+		DBInstanceClass:       attr.DBInstanceClass,
+		DBInstanceStorageInGB: storageInGB,
+		SecurityIPList:        attr.SecurityIPList,
+		Parameters:            params,
+		Endpoint:              endpoint,
+		PrivateEndpoint:       privateEndpoint,
+	}, nil
+}
+
+// describeParameters looks up the engine parameter group values Alibaba
+// Cloud currently reports for an RDS instance, e.g. values previously
+// applied by ModifyParameter or set to a non-default value at creation.
+func (c *client) describeParameters(id string) (map[string]string, error) {
+	req := sdkrds.CreateDescribeParametersRequest()
+	req.DBInstanceId = id
+
+	resp, err := c.rds.DescribeParameters(req)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make(map[string]string, len(resp.RunningParameters.DBInstanceParameter))
+	for _, p := range resp.RunningParameters.DBInstanceParameter {
+		params[p.ParameterName] = p.ParameterValue
+	}
+
+	return params, nil
+}
+
+// describeEndpoints looks up an RDS instance's connection endpoints. An
+// instance attached to a VPC has both a public and a private (intranet)
+// endpoint; a classic network instance has only the former.
+func (c *client) describeEndpoints(id string) (public, private *v1alpha1.Endpoint, err error) {
+	req := sdkrds.CreateDescribeDBInstanceNetInfoRequest()
+	req.DBInstanceId = id
+
+	resp, err := c.rds.DescribeDBInstanceNetInfo(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, info := range resp.DBInstanceNetInfos.DBInstanceNetInfo {
+		ep := &v1alpha1.Endpoint{Address: info.ConnectionString, Port: info.Port}
+		if info.IPType == "Private" {
+			private = ep
+			continue
+		}
+		public = ep
+	}
+
+	return public, private, nil
+}
+
+// CreateDBInstance creates a new RDS instance.
+func (c *client) CreateDBInstance(r *CreateDBInstanceRequest) (*DBInstance, error) {
+	req := sdkrds.CreateCreateDBInstanceRequest()
+	req.Engine = r.Engine
+	req.EngineVersion = r.EngineVersion
+	req.DBInstanceClass = r.DBInstanceClass
+	req.DBInstanceStorage = strconv.Itoa(r.DBInstanceStorageInGB)
+	req.SecurityIPList = r.SecurityIPList
+	req.InstanceNetworkType = r.InstanceNetworkType
+	req.VPCId = r.VPCID
+	req.VSwitchId = r.VSwitchID
+	req.ZoneId = r.ZoneID
+	req.PrivateIpAddress = r.PrivateIPAddress
+
+	resp, err := c.rds.CreateDBInstance(req)
+	if err != nil {
+		return nil, err
+	}
+	return &DBInstance{ID: resp.DBInstanceId}, nil
+}
+
+// CreateAccount creates the master account on an RDS instance.
+func (c *client) CreateAccount(id, user, password string) error {
+	req := sdkrds.CreateCreateAccountRequest()
+	req.DBInstanceId = id
+	req.AccountName = user
+	req.AccountPassword = password
+
+	_, err := c.rds.CreateAccount(req)
+	return err
+}
+
+// DeleteDBInstance deletes an RDS instance.
+func (c *client) DeleteDBInstance(id string) error {
+	req := sdkrds.CreateDeleteDBInstanceRequest()
+	req.DBInstanceId = id
+
+	_, err := c.rds.DeleteDBInstance(req)
+	return err
+}
+
+// ModifyDBInstanceSpec resizes an RDS instance.
+func (c *client) ModifyDBInstanceSpec(id, class string, storageInGB int) error {
+	req := sdkrds.CreateModifyDBInstanceSpecRequest()
+	req.DBInstanceId = id
+	req.DBInstanceClass = class
+	req.DBInstanceStorage = strconv.Itoa(storageInGB)
+
+	_, err := c.rds.ModifyDBInstanceSpec(req)
+	return err
+}
+
+// ModifySecurityIPs replaces an RDS instance's IP allowlist.
+func (c *client) ModifySecurityIPs(id, ipList string) error {
+	req := sdkrds.CreateModifySecurityIpsRequest()
+	req.DBInstanceId = id
+	req.SecurityIps = ipList
+
+	_, err := c.rds.ModifySecurityIps(req)
+	return err
+}
+
+// ModifyParameter applies engine parameter group values to an RDS instance.
+func (c *client) ModifyParameter(id string, params map[string]string) error {
+	b, err := json.Marshal(params)
+	if err != nil {
+		return errors.Wrap(err, errMarshalParameters)
+	}
+
+	req := sdkrds.CreateModifyParameterRequest()
+	req.DBInstanceId = id
+	req.Parameters = string(b)
+
+	_, err = c.rds.ModifyParameter(req)
+	return err
+}
+
+// ResetAccountPassword rotates the password of an existing RDS account.
+func (c *client) ResetAccountPassword(id, user, password string) error {
+	req := sdkrds.CreateResetAccountPasswordRequest()
+	req.DBInstanceId = id
+	req.AccountName = user
+	req.AccountPassword = password
+
+	_, err := c.rds.ResetAccountPassword(req)
+	return err
+}
+
+// DescribeAccount reports whether the named account exists on an RDS
+// instance and is available for use, e.g. once CreateAccount's asynchronous
+// provisioning has finished.
+func (c *client) DescribeAccount(id, user string) (bool, error) {
+	req := sdkrds.CreateDescribeAccountsRequest()
+	req.DBInstanceId = id
+	req.AccountName = user
+
+	resp, err := c.rds.DescribeAccounts(req)
+	if err != nil {
+		return false, err
+	}
+
+	for _, a := range resp.Accounts.DBInstanceAccount {
+		if a.AccountName == user && a.AccountStatus == "Available" {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rds
+
+import (
+	"strings"
+
+	sdkerrors "github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+)
+
+// errCodeDBInstanceNotFound is the error code the Alibaba Cloud RDS API
+// returns when asked to describe, modify, or delete a DBInstanceId it
+// doesn't recognise, e.g. because it was already deleted.
+const errCodeDBInstanceNotFound = "InvalidDBInstanceId.NotFound"
+
+// errCodeThrottlingPrefix is the common prefix of the error codes the
+// Alibaba Cloud API returns when a caller is being rate limited, e.g.
+// Throttling.User and Throttling.Api.
+const errCodeThrottlingPrefix = "Throttling"
+
+// IsNotFound returns true if the supplied error indicates that the
+// referenced RDS instance does not exist.
+func IsNotFound(err error) bool {
+	e, ok := err.(sdkerrors.Error)
+	return ok && e.ErrorCode() == errCodeDBInstanceNotFound
+}
+
+// IsThrottled returns true if the supplied error indicates that a request
+// was rate limited by the Alibaba Cloud API.
+func IsThrottled(err error) bool {
+	e, ok := err.(sdkerrors.Error)
+	return ok && strings.HasPrefix(e.ErrorCode(), errCodeThrottlingPrefix)
+}
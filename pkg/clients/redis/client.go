@@ -0,0 +1,151 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package redis provides a client for the Alibaba Cloud ApsaraDB for Redis
+// (R-KVStore) API.
+package redis
+
+import (
+	"context"
+	"strconv"
+
+	sdkr "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-alibaba/apis/database/v1alpha1"
+)
+
+const (
+	errCreateRedisSDKClient = "cannot create R-KVStore SDK client"
+)
+
+// Instance represents the observed state of an Alibaba Cloud Redis instance.
+type Instance struct {
+	ID            string
+	Status        string
+	InstanceClass string
+	Endpoint      *v1alpha1.Endpoint
+}
+
+// CreateInstanceRequest captures the parameters needed to create a new
+// Redis instance.
+type CreateInstanceRequest struct {
+	Name                string
+	InstanceClass       string
+	EngineVersion       string
+	Capacity            int
+	InstanceNetworkType string
+	VPCID               string
+	VSwitchID           string
+}
+
+// Client implements the Alibaba Cloud R-KVStore API operations this
+// provider depends on.
+type Client interface {
+	DescribeInstance(id string) (*Instance, error)
+	CreateInstance(req *CreateInstanceRequest) (*Instance, error)
+	DeleteInstance(id string) error
+	ModifyInstanceSpec(id, instanceClass string) error
+	ResetPassword(id, password string) error
+}
+
+type client struct {
+	r *sdkr.Client
+}
+
+// NewClient creates a new Client for the given Alibaba Cloud credentials
+// and region. ctx is accepted to match the signature connectors use to
+// inject a client constructor, but SDK client construction does not itself
+// make any calls that need to be cancelled.
+func NewClient(ctx context.Context, accessKeyID, accessKeySecret, region string) (Client, error) {
+	c, err := sdkr.NewClientWithAccessKey(region, accessKeyID, accessKeySecret)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateRedisSDKClient)
+	}
+	return &client{r: c}, nil
+}
+
+// DescribeInstance looks up a Redis instance by ID.
+func (c *client) DescribeInstance(id string) (*Instance, error) {
+	req := sdkr.CreateDescribeInstanceAttributeRequest()
+	req.InstanceId = id
+
+	resp, err := c.r.DescribeInstanceAttribute(req)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Instances.DBInstanceAttribute) == 0 {
+		return nil, errors.Errorf("no instance found for id %s", id)
+	}
+	attr := resp.Instances.DBInstanceAttribute[0]
+
+	return &Instance{
+		ID:            attr.InstanceId,
+		Status:        attr.InstanceStatus,
+		InstanceClass: attr.InstanceClass,
+		Endpoint: &v1alpha1.Endpoint{
+			Address: attr.ConnectionDomain,
+			Port:    strconv.Itoa(attr.Port),
+		},
+	}, nil
+}
+
+// CreateInstance creates a new Redis instance.
+func (c *client) CreateInstance(r *CreateInstanceRequest) (*Instance, error) {
+	req := sdkr.CreateCreateInstanceRequest()
+	req.InstanceName = r.Name
+	req.InstanceClass = r.InstanceClass
+	req.EngineVersion = r.EngineVersion
+	req.Capacity = strconv.Itoa(r.Capacity)
+	req.InstanceNetworkType = r.InstanceNetworkType
+	req.VpcId = r.VPCID
+	req.VSwitchId = r.VSwitchID
+
+	resp, err := c.r.CreateInstance(req)
+	if err != nil {
+		return nil, err
+	}
+	return &Instance{ID: resp.InstanceId}, nil
+}
+
+// DeleteInstance deletes a Redis instance.
+func (c *client) DeleteInstance(id string) error {
+	req := sdkr.CreateDeleteInstanceRequest()
+	req.InstanceId = id
+
+	_, err := c.r.DeleteInstance(req)
+	return err
+}
+
+// ModifyInstanceSpec resizes a Redis instance.
+func (c *client) ModifyInstanceSpec(id, instanceClass string) error {
+	req := sdkr.CreateModifyInstanceSpecRequest()
+	req.InstanceId = id
+	req.InstanceClass = instanceClass
+
+	_, err := c.r.ModifyInstanceSpec(req)
+	return err
+}
+
+// ResetPassword rotates the password of a Redis instance.
+func (c *client) ResetPassword(id, password string) error {
+	req := sdkr.CreateResetAccountPasswordRequest()
+	req.InstanceId = id
+	req.AccountPassword = password
+
+	_, err := c.r.ResetAccountPassword(req)
+	return err
+}
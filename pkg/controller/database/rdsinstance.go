@@ -0,0 +1,408 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package database contains the controllers for this provider's database
+// managed resources, e.g. RDSInstance.
+package database
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-alibaba/apis/database/v1alpha1"
+	aliv1alpha1 "github.com/crossplane/provider-alibaba/apis/v1alpha1"
+	"github.com/crossplane/provider-alibaba/pkg/clients/rds"
+)
+
+const (
+	errNotRDSInstance      = "managed resource is not an RDSInstance custom resource"
+	errTrackUsage          = "cannot track ProviderConfig usage"
+	errGetProviderConfig   = "cannot get ProviderConfig"
+	errGetProvider         = "cannot get Provider"
+	errNoProvider          = "neither providerConfigRef nor providerRef was specified"
+	errNoConnectionSecret  = "ProviderConfig does not specify a connection secret"
+	errGetConnectionSecret = "cannot get connection secret"
+	errCreateRDSClient     = "cannot create new RDS client"
+	errGeneratePassword    = "cannot generate password"
+	errCreateAccount       = "cannot create master account"
+	errModifySpec          = "cannot modify instance class/storage"
+	errModifySecurityIPs   = "cannot modify instance security IP list"
+	errModifyParameter     = "cannot modify instance engine parameters"
+	errGetPasswordSecret   = "cannot get master password secret"
+	errResetPassword       = "cannot reset master account password"
+	errDescribeThrottled   = "request to describe instance was throttled"
+
+	errFmtUnsupportedCredSource = "credentials source %q is not currently supported"
+)
+
+// SetupRDSInstance adds a controller that reconciles RDSInstances.
+func SetupRDSInstance(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.RDSInstanceGroupKind)
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.RDSInstanceGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			client:       mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &aliv1alpha1.ProviderConfigUsage{}),
+			newRDSClient: rds.NewClient,
+		}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.RDSInstance{}).
+		Complete(r)
+}
+
+// connector produces an ExternalClient for RDSInstances given credentials
+// fetched from a referenced ProviderConfig.
+type connector struct {
+	client       client.Client
+	usage        resource.Tracker
+	newRDSClient func(ctx context.Context, accessKeyID, accessKeySecret, region string) (rds.Client, error)
+}
+
+// Connect produces an ExternalClient authenticated with credentials taken
+// from the ProviderConfig referenced by the supplied RDSInstance.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.RDSInstance)
+	if !ok {
+		return nil, errors.New(errNotRDSInstance)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackUsage)
+	}
+
+	var (
+		creds  xpv1.ProviderCredentials
+		region string
+	)
+
+	switch {
+	case cr.Spec.ProviderConfigReference != nil:
+		pc := &aliv1alpha1.ProviderConfig{}
+		if err := c.client.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderConfigReference.Name}, pc); err != nil {
+			return nil, errors.Wrap(err, errGetProviderConfig)
+		}
+		creds, region = pc.Spec.Credentials, pc.Spec.Region
+	case cr.Spec.ProviderReference != nil:
+		// Deprecated: supported for RDSInstances created before
+		// ProviderConfig existed.
+		p := &aliv1alpha1.Provider{}
+		if err := c.client.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+			return nil, errors.Wrap(err, errGetProvider)
+		}
+		creds, region = p.Spec.Credentials, p.Spec.Region
+	default:
+		return nil, errors.New(errNoProvider)
+	}
+
+	if creds.Source != xpv1.CredentialsSourceSecret {
+		return nil, errors.Errorf(errFmtUnsupportedCredSource, creds.Source)
+	}
+
+	ref := creds.SecretRef
+	if ref == nil {
+		return nil, errors.New(errNoConnectionSecret)
+	}
+
+	s := &corev1.Secret{}
+	if err := c.client.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetConnectionSecret)
+	}
+
+	rdsClient, err := c.newRDSClient(ctx,
+		string(s.Data[xpv1.ResourceCredentialsSecretAccessKeyIDKey]),
+		string(s.Data[xpv1.ResourceCredentialsSecretAccessKeySecretKey]),
+		region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateRDSClient)
+	}
+
+	return &external{kube: c.client, client: rdsClient}, nil
+}
+
+// external observes, creates, updates, and deletes RDS instances by talking
+// to the Alibaba Cloud RDS API.
+type external struct {
+	kube   client.Client
+	client rds.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RDSInstance)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRDSInstance)
+	}
+
+	// A freshly created CR may not yet have DBInstanceID recorded in status,
+	// but its external-name annotation may point at a pre-existing instance
+	// to adopt.
+	id := cr.Status.AtProvider.DBInstanceID
+	if id == "" {
+		id = meta.GetExternalName(cr)
+	}
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	instance, err := e.client.DescribeDBInstance(id)
+	if rds.IsNotFound(err) {
+		// The instance we were tracking is gone, e.g. because it was deleted
+		// outside of Crossplane. Report it as missing so the reconciler
+		// creates a new one rather than getting stuck reporting this error.
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if rds.IsThrottled(err) {
+		// Returning an error causes the managed reconciler to requeue with
+		// exponential backoff, which is the right response to throttling.
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribeThrottled)
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.AtProvider.DBInstanceID = id
+	cr.Status.AtProvider.DBInstanceStatus = instance.Status
+	cr.Status.AtProvider.DBInstanceClass = instance.DBInstanceClass
+	cr.Status.AtProvider.DBInstanceStorageInGB = instance.DBInstanceStorageInGB
+	cr.Status.AtProvider.SecurityIPList = instance.SecurityIPList
+	cr.Status.AtProvider.Parameters = instance.Parameters
+	cr.Status.AtProvider.Endpoint = instance.Endpoint
+	cr.Status.AtProvider.PrivateEndpoint = instance.PrivateEndpoint
+	if instance.Status == v1alpha1.RDSInstanceStateRunning {
+		cr.SetConditions(xpv1.Available())
+	}
+
+	if cr.Spec.ForProvider.MasterUsername != "" {
+		ready, err := e.client.DescribeAccount(cr.Status.AtProvider.DBInstanceID, cr.Spec.ForProvider.MasterUsername)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		cr.Status.AtProvider.AccountReady = ready
+	}
+
+	upToDate, err := e.isUpToDate(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: getConnectionDetails("", cr, instance),
+	}, nil
+}
+
+// isUpToDate returns true if the observed state of the supplied RDSInstance
+// matches its desired state closely enough that Update need not be called.
+func (e *external) isUpToDate(ctx context.Context, cr *v1alpha1.RDSInstance) (bool, error) {
+	p := cr.Spec.ForProvider
+	o := cr.Status.AtProvider
+
+	if p.DBInstanceClass != o.DBInstanceClass || p.DBInstanceStorageInGB != o.DBInstanceStorageInGB {
+		return false, nil
+	}
+	if p.SecurityIPList != o.SecurityIPList {
+		return false, nil
+	}
+	if !cmp.Equal(p.Parameters, o.Parameters) {
+		return false, nil
+	}
+
+	version, ok, err := e.desiredPasswordVersion(ctx, cr)
+	if err != nil {
+		return false, err
+	}
+	if ok && version != o.MasterPasswordVersion {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.RDSInstance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRDSInstance)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	p := cr.Spec.ForProvider
+	instance, err := e.client.CreateDBInstance(&rds.CreateDBInstanceRequest{
+		Name:                  meta.GetExternalName(cr),
+		Engine:                p.Engine,
+		EngineVersion:         p.EngineVersion,
+		DBInstanceClass:       p.DBInstanceClass,
+		DBInstanceStorageInGB: p.DBInstanceStorageInGB,
+		SecurityIPList:        p.SecurityIPList,
+		InstanceNetworkType:   p.InstanceNetworkType,
+		VPCID:                 p.VPCID,
+		VSwitchID:             p.VSwitchID,
+		ZoneID:                p.ZoneID,
+		PrivateIPAddress:      p.PrivateIPAddress,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	cr.Status.AtProvider.DBInstanceID = instance.ID
+
+	pw, err := password.Generate()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGeneratePassword)
+	}
+
+	if err := e.client.CreateAccount(instance.ID, p.MasterUsername, pw); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAccount)
+	}
+
+	return managed.ExternalCreation{ConnectionDetails: getConnectionDetails(pw, cr, instance)}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.RDSInstance)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRDSInstance)
+	}
+
+	id := cr.Status.AtProvider.DBInstanceID
+	p := cr.Spec.ForProvider
+	o := cr.Status.AtProvider
+
+	if p.DBInstanceClass != o.DBInstanceClass || p.DBInstanceStorageInGB != o.DBInstanceStorageInGB {
+		if err := e.client.ModifyDBInstanceSpec(id, p.DBInstanceClass, p.DBInstanceStorageInGB); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModifySpec)
+		}
+	}
+
+	if p.SecurityIPList != o.SecurityIPList {
+		if err := e.client.ModifySecurityIPs(id, p.SecurityIPList); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModifySecurityIPs)
+		}
+	}
+
+	if !cmp.Equal(p.Parameters, o.Parameters) {
+		if err := e.client.ModifyParameter(id, p.Parameters); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModifyParameter)
+		}
+		cr.Status.AtProvider.Parameters = p.Parameters
+	}
+
+	if err := e.rotatePassword(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// desiredPasswordVersion returns a hash of the master account password that
+// the secret referenced by MasterPasswordSecretRef currently holds. ok is
+// false when the spec doesn't reference a password secret, in which case the
+// controller doesn't manage the master account password.
+func (e *external) desiredPasswordVersion(ctx context.Context, cr *v1alpha1.RDSInstance) (version string, ok bool, err error) {
+	ref := cr.Spec.ForProvider.MasterPasswordSecretRef
+	if ref == nil {
+		return "", false, nil
+	}
+
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return "", false, errors.Wrap(err, errGetPasswordSecret)
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(s.Data[ref.Key])), true, nil
+}
+
+// rotatePassword resets the master account password when the secret
+// referenced by MasterPasswordSecretRef has changed since it was last
+// applied.
+func (e *external) rotatePassword(ctx context.Context, cr *v1alpha1.RDSInstance) error {
+	version, ok, err := e.desiredPasswordVersion(ctx, cr)
+	if err != nil {
+		return err
+	}
+	if !ok || version == cr.Status.AtProvider.MasterPasswordVersion {
+		return nil
+	}
+
+	ref := cr.Spec.ForProvider.MasterPasswordSecretRef
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return errors.Wrap(err, errGetPasswordSecret)
+	}
+
+	if err := e.client.ResetAccountPassword(cr.Status.AtProvider.DBInstanceID, cr.Spec.ForProvider.MasterUsername, string(s.Data[ref.Key])); err != nil {
+		return errors.Wrap(err, errResetPassword)
+	}
+	cr.Status.AtProvider.MasterPasswordVersion = version
+
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.RDSInstance)
+	if !ok {
+		return errors.New(errNotRDSInstance)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	return e.client.DeleteDBInstance(cr.Status.AtProvider.DBInstanceID)
+}
+
+// getConnectionDetails assembles the connection secret for an RDSInstance.
+// When the instance is attached to a VPC its private endpoint is preferred
+// over its public one, since it is reachable from within the cluster's
+// network without traversing the public internet.
+func getConnectionDetails(pw string, cr *v1alpha1.RDSInstance, i *rds.DBInstance) managed.ConnectionDetails {
+	conn := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretUserKey: []byte(cr.Spec.ForProvider.MasterUsername),
+	}
+
+	if pw != "" {
+		conn[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
+	}
+
+	ep := i.Endpoint
+	if cr.Spec.ForProvider.InstanceNetworkType == v1alpha1.RDSInstanceNetworkTypeVPC && i.PrivateEndpoint != nil {
+		ep = i.PrivateEndpoint
+	}
+
+	if ep != nil {
+		conn[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(ep.Address)
+		conn[xpv1.ResourceCredentialsSecretPortKey] = []byte(ep.Port)
+	}
+
+	return conn
+}
@@ -11,7 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	crossplanemeta "github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -59,8 +59,8 @@ func TestConnector(t *testing.T) {
 			args: args{
 				mg: &v1alpha1.RDSInstance{
 					Spec: v1alpha1.RDSInstanceSpec{
-						ResourceSpec: runtimev1alpha1.ResourceSpec{
-							ProviderConfigReference: &runtimev1alpha1.Reference{},
+						ResourceSpec: xpv1.ResourceSpec{
+							ProviderConfigReference: &xpv1.Reference{},
 						},
 					},
 				},
@@ -78,8 +78,8 @@ func TestConnector(t *testing.T) {
 			args: args{
 				mg: &v1alpha1.RDSInstance{
 					Spec: v1alpha1.RDSInstanceSpec{
-						ResourceSpec: runtimev1alpha1.ResourceSpec{
-							ProviderConfigReference: &runtimev1alpha1.Reference{},
+						ResourceSpec: xpv1.ResourceSpec{
+							ProviderConfigReference: &xpv1.Reference{},
 						},
 					},
 				},
@@ -94,9 +94,9 @@ func TestConnector(t *testing.T) {
 						t := obj.(*aliv1alpha1.ProviderConfig)
 						*t = aliv1alpha1.ProviderConfig{
 							Spec: aliv1alpha1.ProviderConfigSpec{
-								ProviderConfigSpec: runtimev1alpha1.ProviderConfigSpec{
-									Credentials: runtimev1alpha1.ProviderCredentials{
-										Source: runtimev1alpha1.CredentialsSource("wat"),
+								ProviderConfigSpec: xpv1.ProviderConfigSpec{
+									Credentials: xpv1.ProviderCredentials{
+										Source: xpv1.CredentialsSource("wat"),
 									},
 								},
 							},
@@ -109,8 +109,8 @@ func TestConnector(t *testing.T) {
 			args: args{
 				mg: &v1alpha1.RDSInstance{
 					Spec: v1alpha1.RDSInstanceSpec{
-						ResourceSpec: runtimev1alpha1.ResourceSpec{
-							ProviderConfigReference: &runtimev1alpha1.Reference{},
+						ResourceSpec: xpv1.ResourceSpec{
+							ProviderConfigReference: &xpv1.Reference{},
 						},
 					},
 				},
@@ -128,8 +128,8 @@ func TestConnector(t *testing.T) {
 			args: args{
 				mg: &v1alpha1.RDSInstance{
 					Spec: v1alpha1.RDSInstanceSpec{
-						ResourceSpec: runtimev1alpha1.ResourceSpec{
-							ProviderReference: &runtimev1alpha1.Reference{},
+						ResourceSpec: xpv1.ResourceSpec{
+							ProviderReference: &xpv1.Reference{},
 						},
 					},
 				},
@@ -144,9 +144,9 @@ func TestConnector(t *testing.T) {
 						t := obj.(*aliv1alpha1.ProviderConfig)
 						*t = aliv1alpha1.ProviderConfig{
 							Spec: aliv1alpha1.ProviderConfigSpec{
-								ProviderConfigSpec: runtimev1alpha1.ProviderConfigSpec{
-									Credentials: runtimev1alpha1.ProviderCredentials{
-										Source: runtimev1alpha1.CredentialsSourceSecret,
+								ProviderConfigSpec: xpv1.ProviderConfigSpec{
+									Credentials: xpv1.ProviderCredentials{
+										Source: xpv1.CredentialsSourceSecret,
 									},
 								},
 							},
@@ -159,8 +159,8 @@ func TestConnector(t *testing.T) {
 			args: args{
 				mg: &v1alpha1.RDSInstance{
 					Spec: v1alpha1.RDSInstanceSpec{
-						ResourceSpec: runtimev1alpha1.ResourceSpec{
-							ProviderConfigReference: &runtimev1alpha1.Reference{},
+						ResourceSpec: xpv1.ResourceSpec{
+							ProviderConfigReference: &xpv1.Reference{},
 						},
 					},
 				},
@@ -178,11 +178,11 @@ func TestConnector(t *testing.T) {
 						case *aliv1alpha1.ProviderConfig:
 							*t = aliv1alpha1.ProviderConfig{
 								Spec: aliv1alpha1.ProviderConfigSpec{
-									ProviderConfigSpec: runtimev1alpha1.ProviderConfigSpec{
-										Credentials: runtimev1alpha1.ProviderCredentials{
-											Source: runtimev1alpha1.CredentialsSourceSecret,
-											SecretRef: &runtimev1alpha1.SecretKeySelector{
-												SecretReference: runtimev1alpha1.SecretReference{
+									ProviderConfigSpec: xpv1.ProviderConfigSpec{
+										Credentials: xpv1.ProviderCredentials{
+											Source: xpv1.CredentialsSourceSecret,
+											SecretRef: &xpv1.SecretKeySelector{
+												SecretReference: xpv1.SecretReference{
 													Name: "coolsecret",
 												},
 											},
@@ -199,8 +199,8 @@ func TestConnector(t *testing.T) {
 			args: args{
 				mg: &v1alpha1.RDSInstance{
 					Spec: v1alpha1.RDSInstanceSpec{
-						ResourceSpec: runtimev1alpha1.ResourceSpec{
-							ProviderConfigReference: &runtimev1alpha1.Reference{},
+						ResourceSpec: xpv1.ResourceSpec{
+							ProviderConfigReference: &xpv1.Reference{},
 						},
 					},
 				},
@@ -215,11 +215,11 @@ func TestConnector(t *testing.T) {
 						if t, ok := obj.(*aliv1alpha1.ProviderConfig); ok {
 							*t = aliv1alpha1.ProviderConfig{
 								Spec: aliv1alpha1.ProviderConfigSpec{
-									ProviderConfigSpec: runtimev1alpha1.ProviderConfigSpec{
-										Credentials: runtimev1alpha1.ProviderCredentials{
-											Source: runtimev1alpha1.CredentialsSourceSecret,
-											SecretRef: &runtimev1alpha1.SecretKeySelector{
-												SecretReference: runtimev1alpha1.SecretReference{
+									ProviderConfigSpec: xpv1.ProviderConfigSpec{
+										Credentials: xpv1.ProviderCredentials{
+											Source: xpv1.CredentialsSourceSecret,
+											SecretRef: &xpv1.SecretKeySelector{
+												SecretReference: xpv1.SecretReference{
 													Name: "coolsecret",
 												},
 											},
@@ -239,8 +239,8 @@ func TestConnector(t *testing.T) {
 			args: args{
 				mg: &v1alpha1.RDSInstance{
 					Spec: v1alpha1.RDSInstanceSpec{
-						ResourceSpec: runtimev1alpha1.ResourceSpec{
-							ProviderConfigReference: &runtimev1alpha1.Reference{},
+						ResourceSpec: xpv1.ResourceSpec{
+							ProviderConfigReference: &xpv1.Reference{},
 						},
 					},
 				},
@@ -284,11 +284,163 @@ func TestExternalClientObserve(t *testing.T) {
 	if obj.Status.AtProvider.AccountReady != true {
 		t.Error("AccountReady should be true")
 	}
-	if string(ob.ConnectionDetails[runtimev1alpha1.ResourceCredentialsSecretUserKey]) != testName {
+	if string(ob.ConnectionDetails[xpv1.ResourceCredentialsSecretUserKey]) != testName {
 		t.Error("ConnectionDetails should include username=test")
 	}
+	if !ob.ResourceUpToDate {
+		t.Error("ResourceUpToDate should be true when observed and desired state match")
+	}
 }
 
+func TestExternalClientObserveDrift(t *testing.T) {
+	e := &external{client: &fakeRDSClient{
+		MockDescribeDBInstance: func(id string) (*rds.DBInstance, error) {
+			return &rds.DBInstance{
+				ID:              id,
+				Status:          v1alpha1.RDSInstanceStateRunning,
+				DBInstanceClass: "rds.pg.s1.small",
+			}, nil
+		},
+	}}
+	obj := &v1alpha1.RDSInstance{
+		Spec: v1alpha1.RDSInstanceSpec{
+			ForProvider: v1alpha1.RDSInstanceParameters{
+				MasterUsername:  testName,
+				DBInstanceClass: "rds.pg.s2.large",
+			},
+		},
+		Status: v1alpha1.RDSInstanceStatus{
+			AtProvider: v1alpha1.RDSInstanceObservation{
+				DBInstanceID: testName,
+			},
+		},
+	}
+	ob, err := e.Observe(context.Background(), obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ob.ResourceUpToDate {
+		t.Error("ResourceUpToDate should be false when spec.forProvider.dbInstanceClass differs from the observed class")
+	}
+}
+
+func TestExternalClientObserveParameterDrift(t *testing.T) {
+	e := &external{client: &fakeRDSClient{
+		MockDescribeDBInstance: func(id string) (*rds.DBInstance, error) {
+			return &rds.DBInstance{
+				ID:         id,
+				Status:     v1alpha1.RDSInstanceStateRunning,
+				Parameters: map[string]string{"max_connections": "100"},
+			}, nil
+		},
+	}}
+	obj := &v1alpha1.RDSInstance{
+		Spec: v1alpha1.RDSInstanceSpec{
+			ForProvider: v1alpha1.RDSInstanceParameters{
+				Parameters: map[string]string{"max_connections": "200"},
+			},
+		},
+		Status: v1alpha1.RDSInstanceStatus{
+			AtProvider: v1alpha1.RDSInstanceObservation{
+				DBInstanceID: testName,
+				Parameters:   map[string]string{"max_connections": "200"},
+			},
+		},
+	}
+	ob, err := e.Observe(context.Background(), obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(map[string]string{"max_connections": "100"}, obj.Status.AtProvider.Parameters); diff != "" {
+		t.Errorf("Status.AtProvider.Parameters should reflect the cloud-observed value, not the last-applied one: -want, +got:\n%s", diff)
+	}
+	if ob.ResourceUpToDate {
+		t.Error("ResourceUpToDate should be false when observed engine parameters differ from spec")
+	}
+}
+
+func TestExternalClientObserveAccountNotReady(t *testing.T) {
+	e := &external{client: &fakeRDSClient{
+		MockDescribeAccount: func(id, user string) (bool, error) {
+			return false, nil
+		},
+	}}
+	obj := &v1alpha1.RDSInstance{
+		Spec: v1alpha1.RDSInstanceSpec{
+			ForProvider: v1alpha1.RDSInstanceParameters{
+				MasterUsername: testName,
+			},
+		},
+		Status: v1alpha1.RDSInstanceStatus{
+			AtProvider: v1alpha1.RDSInstanceObservation{
+				DBInstanceID: testName,
+			},
+		},
+	}
+	if _, err := e.Observe(context.Background(), obj); err != nil {
+		t.Fatal(err)
+	}
+	if obj.Status.AtProvider.AccountReady {
+		t.Error("AccountReady should be false while the master account is still provisioning")
+	}
+}
+
+func TestExternalClientObserveAdoptsByExternalName(t *testing.T) {
+	e := &external{client: &fakeRDSClient{}}
+	obj := &v1alpha1.RDSInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				crossplanemeta.AnnotationKeyExternalName: testName,
+			},
+		},
+	}
+	ob, err := e.Observe(context.Background(), obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ob.ResourceExists {
+		t.Error("ResourceExists should be true for a pre-existing instance identified by external-name")
+	}
+	if obj.Status.AtProvider.DBInstanceID != testName {
+		t.Errorf("DBInstanceID should be adopted from the external-name annotation, got %q", obj.Status.AtProvider.DBInstanceID)
+	}
+}
+
+func TestExternalClientObserveNotFound(t *testing.T) {
+	e := &external{client: &fakeRDSClient{
+		MockDescribeDBInstance: func(id string) (*rds.DBInstance, error) {
+			return nil, &fakeSDKError{code: "InvalidDBInstanceId.NotFound"}
+		},
+	}}
+	obj := &v1alpha1.RDSInstance{
+		Status: v1alpha1.RDSInstanceStatus{
+			AtProvider: v1alpha1.RDSInstanceObservation{
+				DBInstanceID: testName,
+			},
+		},
+	}
+	ob, err := e.Observe(context.Background(), obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ob.ResourceExists {
+		t.Error("ResourceExists should be false when the instance no longer exists upstream")
+	}
+}
+
+// fakeSDKError implements the github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors
+// Error interface so tests can exercise rds.IsNotFound/IsThrottled without a
+// live Alibaba Cloud API response.
+type fakeSDKError struct {
+	code string
+}
+
+func (e *fakeSDKError) Error() string      { return e.code }
+func (e *fakeSDKError) ErrorCode() string  { return e.code }
+func (e *fakeSDKError) Message() string    { return e.code }
+func (e *fakeSDKError) OriginError() error { return nil }
+func (e *fakeSDKError) HttpStatus() int    { return 404 }
+
 func TestExternalClientCreate(t *testing.T) {
 	e := &external{client: &fakeRDSClient{}}
 	obj := &v1alpha1.RDSInstance{
@@ -315,8 +467,8 @@ func TestExternalClientCreate(t *testing.T) {
 	if obj.Status.AtProvider.DBInstanceID != testName {
 		t.Error("DBInstanceID should be set to 'test'")
 	}
-	if string(ob.ConnectionDetails[runtimev1alpha1.ResourceCredentialsSecretEndpointKey]) != "172.0.0.1" ||
-		string(ob.ConnectionDetails[runtimev1alpha1.ResourceCredentialsSecretPortKey]) != "8888" {
+	if string(ob.ConnectionDetails[xpv1.ResourceCredentialsSecretEndpointKey]) != "172.0.0.1" ||
+		string(ob.ConnectionDetails[xpv1.ResourceCredentialsSecretPortKey]) != "8888" {
 		t.Error("ConnectionDetails should include endpoint=172.0.0.1 and port=8888")
 	}
 }
@@ -336,6 +488,77 @@ func TestExternalClientDelete(t *testing.T) {
 	}
 }
 
+func TestExternalClientUpdate(t *testing.T) {
+	newInstance := func() *v1alpha1.RDSInstance {
+		return &v1alpha1.RDSInstance{
+			Spec: v1alpha1.RDSInstanceSpec{
+				ForProvider: v1alpha1.RDSInstanceParameters{
+					MasterUsername:        testName,
+					DBInstanceClass:       "rds.pg.s1.small",
+					DBInstanceStorageInGB: 20,
+					SecurityIPList:        "0.0.0.0/0",
+				},
+			},
+			Status: v1alpha1.RDSInstanceStatus{
+				AtProvider: v1alpha1.RDSInstanceObservation{
+					DBInstanceID:          testName,
+					DBInstanceClass:       "rds.pg.s1.small",
+					DBInstanceStorageInGB: 20,
+					SecurityIPList:        "0.0.0.0/0",
+				},
+			},
+		}
+	}
+
+	t.Run("SpecDriftTriggersOneModify", func(t *testing.T) {
+		var calls int
+		fake := &fakeRDSClient{
+			MockModifyDBInstanceSpec: func(id, class string, storageInGB int) error {
+				calls++
+				if id != testName || class != "rds.pg.s2.large" || storageInGB != 20 {
+					return errors.New("ModifyDBInstanceSpec: client doesn't work")
+				}
+				return nil
+			},
+		}
+		e := &external{client: fake}
+
+		obj := newInstance()
+		obj.Spec.ForProvider.DBInstanceClass = "rds.pg.s2.large"
+
+		if _, err := e.Update(context.Background(), obj); err != nil {
+			t.Fatal(err)
+		}
+		if calls != 1 {
+			t.Errorf("ModifyDBInstanceSpec calls = %d, want 1", calls)
+		}
+	})
+
+	t.Run("UnchangedSpecIsNoop", func(t *testing.T) {
+		var calls int
+		fake := &fakeRDSClient{
+			MockModifyDBInstanceSpec: func(id, class string, storageInGB int) error {
+				calls++
+				return nil
+			},
+			MockModifySecurityIPs: func(id, ipList string) error {
+				calls++
+				return nil
+			},
+		}
+		e := &external{client: fake}
+
+		obj := newInstance()
+
+		if _, err := e.Update(context.Background(), obj); err != nil {
+			t.Fatal(err)
+		}
+		if calls != 0 {
+			t.Errorf("modify calls = %d, want 0", calls)
+		}
+	})
+}
+
 func TestGetConnectionDetails(t *testing.T) {
 	address := "0.0.0.0"
 	port := "3346"
@@ -378,9 +601,9 @@ func TestGetConnectionDetails(t *testing.T) {
 			},
 			want: want{
 				conn: managed.ConnectionDetails{
-					runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(testName),
-					runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(address),
-					runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte(port),
+					xpv1.ResourceCredentialsSecretUserKey:     []byte(testName),
+					xpv1.ResourceCredentialsSecretEndpointKey: []byte(address),
+					xpv1.ResourceCredentialsSecretPortKey:     []byte(port),
 				},
 			},
 		},
@@ -403,8 +626,8 @@ func TestGetConnectionDetails(t *testing.T) {
 			},
 			want: want{
 				conn: managed.ConnectionDetails{
-					runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(testName),
-					runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(password),
+					xpv1.ResourceCredentialsSecretUserKey:     []byte(testName),
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte(password),
 				},
 			},
 		},
@@ -432,10 +655,10 @@ func TestGetConnectionDetails(t *testing.T) {
 			},
 			want: want{
 				conn: managed.ConnectionDetails{
-					runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(testName),
-					runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(password),
-					runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(address),
-					runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte(port),
+					xpv1.ResourceCredentialsSecretUserKey:     []byte(testName),
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte(password),
+					xpv1.ResourceCredentialsSecretEndpointKey: []byte(address),
+					xpv1.ResourceCredentialsSecretPortKey:     []byte(port),
 				},
 			},
 		},
@@ -452,9 +675,18 @@ func TestGetConnectionDetails(t *testing.T) {
 }
 
 type fakeRDSClient struct {
+	MockDescribeDBInstance   func(id string) (*rds.DBInstance, error)
+	MockModifyDBInstanceSpec func(id, class string, storageInGB int) error
+	MockModifySecurityIPs    func(id, ipList string) error
+	MockModifyParameter      func(id string, params map[string]string) error
+	MockResetAccountPassword func(id, user, password string) error
+	MockDescribeAccount      func(id, user string) (bool, error)
 }
 
 func (c *fakeRDSClient) DescribeDBInstance(id string) (*rds.DBInstance, error) {
+	if c.MockDescribeDBInstance != nil {
+		return c.MockDescribeDBInstance(id)
+	}
 	if id != testName {
 		return nil, errors.New("DescribeDBInstance: client doesn't work")
 	}
@@ -490,3 +722,38 @@ func (c *fakeRDSClient) DeleteDBInstance(id string) error {
 	}
 	return nil
 }
+
+func (c *fakeRDSClient) ModifyDBInstanceSpec(id, class string, storageInGB int) error {
+	if c.MockModifyDBInstanceSpec != nil {
+		return c.MockModifyDBInstanceSpec(id, class, storageInGB)
+	}
+	return nil
+}
+
+func (c *fakeRDSClient) ModifySecurityIPs(id, ipList string) error {
+	if c.MockModifySecurityIPs != nil {
+		return c.MockModifySecurityIPs(id, ipList)
+	}
+	return nil
+}
+
+func (c *fakeRDSClient) ModifyParameter(id string, params map[string]string) error {
+	if c.MockModifyParameter != nil {
+		return c.MockModifyParameter(id, params)
+	}
+	return nil
+}
+
+func (c *fakeRDSClient) ResetAccountPassword(id, user, password string) error {
+	if c.MockResetAccountPassword != nil {
+		return c.MockResetAccountPassword(id, user, password)
+	}
+	return nil
+}
+
+func (c *fakeRDSClient) DescribeAccount(id, user string) (bool, error) {
+	if c.MockDescribeAccount != nil {
+		return c.MockDescribeAccount(id, user)
+	}
+	return id == testName, nil
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-alibaba/apis/database/v1alpha1"
+	aliv1alpha1 "github.com/crossplane/provider-alibaba/apis/v1alpha1"
+	"github.com/crossplane/provider-alibaba/pkg/clients/redis"
+)
+
+const (
+	errNotRedisInstance  = "managed resource is not a RedisInstance custom resource"
+	errCreateRedisClient = "cannot create new Redis client"
+)
+
+// SetupRedisInstance adds a controller that reconciles RedisInstances.
+func SetupRedisInstance(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.RedisInstanceGroupKind)
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.RedisInstanceGroupVersionKind),
+		managed.WithExternalConnecter(&redisConnector{
+			client:         mgr.GetClient(),
+			usage:          resource.NewProviderConfigUsageTracker(mgr.GetClient(), &aliv1alpha1.ProviderConfigUsage{}),
+			newRedisClient: redis.NewClient,
+		}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.RedisInstance{}).
+		Complete(r)
+}
+
+// redisConnector produces an ExternalClient for RedisInstances given
+// credentials fetched from a referenced ProviderConfig. It follows the same
+// ProviderConfig/Provider resolution as the RDSInstance connector.
+type redisConnector struct {
+	client         client.Client
+	usage          resource.Tracker
+	newRedisClient func(ctx context.Context, accessKeyID, accessKeySecret, region string) (redis.Client, error)
+}
+
+// Connect produces an ExternalClient authenticated with credentials taken
+// from the ProviderConfig referenced by the supplied RedisInstance.
+func (c *redisConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.RedisInstance)
+	if !ok {
+		return nil, errors.New(errNotRedisInstance)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackUsage)
+	}
+
+	var (
+		creds  xpv1.ProviderCredentials
+		region string
+	)
+
+	switch {
+	case cr.Spec.ProviderConfigReference != nil:
+		pc := &aliv1alpha1.ProviderConfig{}
+		if err := c.client.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderConfigReference.Name}, pc); err != nil {
+			return nil, errors.Wrap(err, errGetProviderConfig)
+		}
+		creds, region = pc.Spec.Credentials, pc.Spec.Region
+	case cr.Spec.ProviderReference != nil:
+		// Deprecated: supported for RedisInstances created before
+		// ProviderConfig existed.
+		p := &aliv1alpha1.Provider{}
+		if err := c.client.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+			return nil, errors.Wrap(err, errGetProvider)
+		}
+		creds, region = p.Spec.Credentials, p.Spec.Region
+	default:
+		return nil, errors.New(errNoProvider)
+	}
+
+	if creds.Source != xpv1.CredentialsSourceSecret {
+		return nil, errors.Errorf(errFmtUnsupportedCredSource, creds.Source)
+	}
+
+	ref := creds.SecretRef
+	if ref == nil {
+		return nil, errors.New(errNoConnectionSecret)
+	}
+
+	s := &corev1.Secret{}
+	if err := c.client.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetConnectionSecret)
+	}
+
+	redisClient, err := c.newRedisClient(ctx,
+		string(s.Data[xpv1.ResourceCredentialsSecretAccessKeyIDKey]),
+		string(s.Data[xpv1.ResourceCredentialsSecretAccessKeySecretKey]),
+		region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateRedisClient)
+	}
+
+	return &redisExternal{client: redisClient}, nil
+}
+
+// redisExternal observes, creates, updates, and deletes Redis instances by
+// talking to the Alibaba Cloud R-KVStore API.
+type redisExternal struct {
+	client redis.Client
+}
+
+func (e *redisExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RedisInstance)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRedisInstance)
+	}
+
+	if cr.Status.AtProvider.InstanceID == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	instance, err := e.client.DescribeInstance(cr.Status.AtProvider.InstanceID)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.AtProvider.InstanceStatus = instance.Status
+	cr.Status.AtProvider.InstanceClass = instance.InstanceClass
+	if instance.Status == v1alpha1.RedisInstanceStateRunning {
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  cr.Spec.ForProvider.InstanceClass == instance.InstanceClass,
+		ConnectionDetails: getRedisConnectionDetails("", instance),
+	}, nil
+}
+
+func (e *redisExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.RedisInstance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRedisInstance)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	p := cr.Spec.ForProvider
+	instance, err := e.client.CreateInstance(&redis.CreateInstanceRequest{
+		Name:                meta.GetExternalName(cr),
+		InstanceClass:       p.InstanceClass,
+		EngineVersion:       p.EngineVersion,
+		Capacity:            p.Capacity,
+		InstanceNetworkType: p.InstanceNetworkType,
+		VPCID:               p.VPCID,
+		VSwitchID:           p.VSwitchID,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	cr.Status.AtProvider.InstanceID = instance.ID
+
+	pw, err := password.Generate()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGeneratePassword)
+	}
+
+	if err := e.client.ResetPassword(instance.ID, pw); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errResetPassword)
+	}
+
+	return managed.ExternalCreation{ConnectionDetails: getRedisConnectionDetails(pw, instance)}, nil
+}
+
+func (e *redisExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.RedisInstance)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRedisInstance)
+	}
+
+	if cr.Spec.ForProvider.InstanceClass != cr.Status.AtProvider.InstanceClass {
+		if err := e.client.ModifyInstanceSpec(cr.Status.AtProvider.InstanceID, cr.Spec.ForProvider.InstanceClass); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModifySpec)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *redisExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.RedisInstance)
+	if !ok {
+		return errors.New(errNotRedisInstance)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	return e.client.DeleteInstance(cr.Status.AtProvider.InstanceID)
+}
+
+// getRedisConnectionDetails assembles the connection secret for a
+// RedisInstance.
+func getRedisConnectionDetails(pw string, i *redis.Instance) managed.ConnectionDetails {
+	conn := managed.ConnectionDetails{}
+
+	if pw != "" {
+		conn[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
+	}
+
+	if i.Endpoint != nil {
+		conn[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(i.Endpoint.Address)
+		conn[xpv1.ResourceCredentialsSecretPortKey] = []byte(i.Endpoint.Port)
+	}
+
+	return conn
+}
@@ -0,0 +1,365 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	crossplanemeta "github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-alibaba/apis/database/v1alpha1"
+	aliv1alpha1 "github.com/crossplane/provider-alibaba/apis/v1alpha1"
+	"github.com/crossplane/provider-alibaba/pkg/clients/redis"
+)
+
+func TestRedisConnector(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type fields struct {
+		client         client.Client
+		usage          resource.Tracker
+		newRedisClient func(ctx context.Context, accessKeyID, accessKeySecret, region string) (redis.Client, error)
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   error
+	}{
+		"NotRedisInstance": {
+			reason: "Should return an error if the supplied managed resource is not a RedisInstance",
+			args: args{
+				mg: nil,
+			},
+			want: errors.New(errNotRedisInstance),
+		},
+		"TrackProviderConfigUsageError": {
+			reason: "Errors tracking a ProviderConfigUsage should be returned",
+			fields: fields{
+				usage: resource.TrackerFn(func(ctx context.Context, mg resource.Managed) error { return errBoom }),
+			},
+			args: args{
+				mg: &v1alpha1.RedisInstance{
+					Spec: v1alpha1.RedisInstanceSpec{
+						ResourceSpec: xpv1.ResourceSpec{
+							ProviderConfigReference: &xpv1.Reference{},
+						},
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errTrackUsage),
+		},
+		"GetProviderConfigError": {
+			reason: "Errors getting a ProviderConfig should be returned",
+			fields: fields{
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				usage: resource.TrackerFn(func(ctx context.Context, mg resource.Managed) error { return nil }),
+			},
+			args: args{
+				mg: &v1alpha1.RedisInstance{
+					Spec: v1alpha1.RedisInstanceSpec{
+						ResourceSpec: xpv1.ResourceSpec{
+							ProviderConfigReference: &xpv1.Reference{},
+						},
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errGetProviderConfig),
+		},
+		"NewRedisClientError": {
+			reason: "Errors creating a new Redis client should be returned",
+			fields: fields{
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj runtime.Object) error {
+						if t, ok := obj.(*aliv1alpha1.ProviderConfig); ok {
+							*t = aliv1alpha1.ProviderConfig{
+								Spec: aliv1alpha1.ProviderConfigSpec{
+									ProviderConfigSpec: xpv1.ProviderConfigSpec{
+										Credentials: xpv1.ProviderCredentials{
+											Source: xpv1.CredentialsSourceSecret,
+											SecretRef: &xpv1.SecretKeySelector{
+												SecretReference: xpv1.SecretReference{
+													Name: "coolsecret",
+												},
+											},
+										},
+									},
+								},
+							}
+						}
+						return nil
+					}),
+				},
+				usage: resource.TrackerFn(func(ctx context.Context, mg resource.Managed) error { return nil }),
+				newRedisClient: func(ctx context.Context, accessKeyID, accessKeySecret, region string) (redis.Client, error) {
+					return nil, errBoom
+				},
+			},
+			args: args{
+				mg: &v1alpha1.RedisInstance{
+					Spec: v1alpha1.RedisInstanceSpec{
+						ResourceSpec: xpv1.ResourceSpec{
+							ProviderConfigReference: &xpv1.Reference{},
+						},
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errCreateRedisClient),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &redisConnector{client: tc.fields.client, usage: tc.fields.usage, newRedisClient: tc.fields.newRedisClient}
+			_, err := c.Connect(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nc.Connect(...) -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRedisExternalClientObserve(t *testing.T) {
+	e := &redisExternal{client: &fakeRedisClient{}}
+	obj := &v1alpha1.RedisInstance{
+		Status: v1alpha1.RedisInstanceStatus{
+			AtProvider: v1alpha1.RedisInstanceObservation{
+				InstanceID: testName,
+			},
+		},
+	}
+	ob, err := e.Observe(context.Background(), obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ob.ResourceExists {
+		t.Error("ResourceExists should be true")
+	}
+	if obj.Status.AtProvider.InstanceStatus != v1alpha1.RedisInstanceStateRunning {
+		t.Errorf("InstanceStatus (%v) should be %v", obj.Status.AtProvider.InstanceStatus, v1alpha1.RedisInstanceStateRunning)
+	}
+	if !ob.ResourceUpToDate {
+		t.Error("ResourceUpToDate should be true when observed and desired instance class match")
+	}
+}
+
+func TestRedisExternalClientObserveDrift(t *testing.T) {
+	e := &redisExternal{client: &fakeRedisClient{
+		MockDescribeInstance: func(id string) (*redis.Instance, error) {
+			return &redis.Instance{
+				ID:            id,
+				Status:        v1alpha1.RedisInstanceStateRunning,
+				InstanceClass: "redis.master.small.default",
+			}, nil
+		},
+	}}
+	obj := &v1alpha1.RedisInstance{
+		Spec: v1alpha1.RedisInstanceSpec{
+			ForProvider: v1alpha1.RedisInstanceParameters{
+				InstanceClass: "redis.master.large.default",
+			},
+		},
+		Status: v1alpha1.RedisInstanceStatus{
+			AtProvider: v1alpha1.RedisInstanceObservation{
+				InstanceID: testName,
+			},
+		},
+	}
+	ob, err := e.Observe(context.Background(), obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ob.ResourceUpToDate {
+		t.Error("ResourceUpToDate should be false when spec.forProvider.instanceClass differs from the observed class")
+	}
+}
+
+func TestRedisExternalClientUpdate(t *testing.T) {
+	newInstance := func() *v1alpha1.RedisInstance {
+		return &v1alpha1.RedisInstance{
+			Spec: v1alpha1.RedisInstanceSpec{
+				ForProvider: v1alpha1.RedisInstanceParameters{
+					InstanceClass: "redis.master.small.default",
+				},
+			},
+			Status: v1alpha1.RedisInstanceStatus{
+				AtProvider: v1alpha1.RedisInstanceObservation{
+					InstanceID:    testName,
+					InstanceClass: "redis.master.small.default",
+				},
+			},
+		}
+	}
+
+	t.Run("SpecDriftTriggersOneModify", func(t *testing.T) {
+		var calls int
+		fake := &fakeRedisClient{
+			MockModifyInstanceSpec: func(id, instanceClass string) error {
+				calls++
+				if id != testName || instanceClass != "redis.master.large.default" {
+					return errors.New("ModifyInstanceSpec: client doesn't work")
+				}
+				return nil
+			},
+		}
+		e := &redisExternal{client: fake}
+
+		obj := newInstance()
+		obj.Spec.ForProvider.InstanceClass = "redis.master.large.default"
+
+		if _, err := e.Update(context.Background(), obj); err != nil {
+			t.Fatal(err)
+		}
+		if calls != 1 {
+			t.Errorf("ModifyInstanceSpec calls = %d, want 1", calls)
+		}
+	})
+
+	t.Run("UnchangedSpecIsNoop", func(t *testing.T) {
+		var calls int
+		fake := &fakeRedisClient{
+			MockModifyInstanceSpec: func(id, instanceClass string) error {
+				calls++
+				return nil
+			},
+		}
+		e := &redisExternal{client: fake}
+
+		if _, err := e.Update(context.Background(), newInstance()); err != nil {
+			t.Fatal(err)
+		}
+		if calls != 0 {
+			t.Errorf("ModifyInstanceSpec calls = %d, want 0", calls)
+		}
+	})
+}
+
+func TestRedisExternalClientCreate(t *testing.T) {
+	e := &redisExternal{client: &fakeRedisClient{}}
+	obj := &v1alpha1.RedisInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				crossplanemeta.AnnotationKeyExternalName: testName,
+			},
+		},
+		Spec: v1alpha1.RedisInstanceSpec{
+			ForProvider: v1alpha1.RedisInstanceParameters{
+				InstanceClass: "redis.master.small.default",
+				EngineVersion: "5.0",
+				Capacity:      1024,
+			},
+		},
+	}
+	ob, err := e.Create(context.Background(), obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if obj.Status.AtProvider.InstanceID != testName {
+		t.Error("InstanceID should be set to 'test'")
+	}
+	if string(ob.ConnectionDetails[xpv1.ResourceCredentialsSecretEndpointKey]) != "172.0.0.2" ||
+		string(ob.ConnectionDetails[xpv1.ResourceCredentialsSecretPortKey]) != "6379" {
+		t.Error("ConnectionDetails should include endpoint=172.0.0.2 and port=6379")
+	}
+}
+
+func TestRedisExternalClientDelete(t *testing.T) {
+	e := &redisExternal{client: &fakeRedisClient{}}
+	obj := &v1alpha1.RedisInstance{
+		Status: v1alpha1.RedisInstanceStatus{
+			AtProvider: v1alpha1.RedisInstanceObservation{
+				InstanceID: testName,
+			},
+		},
+	}
+	if err := e.Delete(context.Background(), obj); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type fakeRedisClient struct {
+	MockDescribeInstance   func(id string) (*redis.Instance, error)
+	MockModifyInstanceSpec func(id, instanceClass string) error
+}
+
+func (c *fakeRedisClient) DescribeInstance(id string) (*redis.Instance, error) {
+	if c.MockDescribeInstance != nil {
+		return c.MockDescribeInstance(id)
+	}
+	if id != testName {
+		return nil, errors.New("DescribeInstance: client doesn't work")
+	}
+	return &redis.Instance{
+		ID:     id,
+		Status: v1alpha1.RedisInstanceStateRunning,
+	}, nil
+}
+
+func (c *fakeRedisClient) CreateInstance(req *redis.CreateInstanceRequest) (*redis.Instance, error) {
+	if req.Name != testName || req.InstanceClass != "redis.master.small.default" {
+		return nil, errors.New("CreateInstance: client doesn't work")
+	}
+	return &redis.Instance{
+		ID: testName,
+		Endpoint: &v1alpha1.Endpoint{
+			Address: "172.0.0.2",
+			Port:    "6379",
+		},
+	}, nil
+}
+
+func (c *fakeRedisClient) DeleteInstance(id string) error {
+	if id != testName {
+		return errors.New("DeleteInstance: client doesn't work")
+	}
+	return nil
+}
+
+func (c *fakeRedisClient) ModifyInstanceSpec(id, instanceClass string) error {
+	if c.MockModifyInstanceSpec != nil {
+		return c.MockModifyInstanceSpec(id, instanceClass)
+	}
+	if id != testName {
+		return errors.New("ModifyInstanceSpec: client doesn't work")
+	}
+	return nil
+}
+
+func (c *fakeRedisClient) ResetPassword(id, password string) error {
+	if id != testName {
+		return errors.New("ResetPassword: client doesn't work")
+	}
+	return nil
+}